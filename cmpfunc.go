@@ -0,0 +1,44 @@
+package indexed
+
+import "slices"
+
+// SortUniqueFunc sorts *s using cmp and then truncates it in-place so that
+// only the first element of each run of elements for which cmp returns 0
+// remains. The return value is the number of unique elements, and *s is
+// resliced to that length, matching the convention SortUniqueBy,
+// SortUniqueOrdered, and slicesx.SortUnique already use.
+//
+// Unlike SortUniqueBy, which takes a less func(a, b T) bool, SortUniqueFunc
+// takes a three-way comparator compatible with cmp.Compare and
+// slices.SortFunc, so adjacent elements are considered duplicates exactly
+// when cmp(a, b) == 0 rather than when !less(a, b) && !less(b, a). This
+// lets callers reuse a cmp.Or/cmp.Compare chain they already have instead
+// of re-expressing it as a strict less predicate.
+func SortUniqueFunc[S ~[]E, E any](s *S, cmp func(a, b E) int) int {
+	v := *s
+	if len(v) == 0 {
+		return 0
+	}
+	slices.SortFunc(v, cmp)
+
+	// Invariant: All the elements of v at positions ≤ i are unique.
+	i, j := 0, 1
+	for j < len(v) {
+		if cmp(v[i], v[j]) != 0 {
+			i++
+			if i != j {
+				v[i], v[j] = v[j], v[i]
+			}
+		}
+		j++
+	}
+	*s = v[:i+1]
+	return i + 1
+}
+
+// For a stable partition — one that preserves the relative order of both
+// the kept and dropped groups — see StablePartitionSlice and
+// StablePartitionBuffered, rather than reimplementing one here. Note that
+// neither of those takes a three-way comparator: both take a boolean keep
+// predicate, and this package does not offer a three-way-comparator stable
+// partition primitive.
@@ -0,0 +1,28 @@
+package indexed
+
+import (
+	stdcmp "cmp"
+	"testing"
+)
+
+func TestSortUniqueFunc(t *testing.T) {
+	tests := []struct {
+		input []string
+		want  int
+	}{
+		{nil, 0},
+		{[]string{}, 0},
+		{[]string{"apple"}, 1},
+		{[]string{"plum", "cherry", "apple", "apple", "plum", "apple", "cherry"}, 3},
+	}
+	for _, test := range tests {
+		result := append([]string(nil), test.input...)
+		got := SortUniqueFunc(&result, stdcmp.Compare[string])
+		if got != test.want {
+			t.Errorf("SortUniqueFunc(%+q): got %d, want %d", test.input, got, test.want)
+		}
+		if len(result) != got {
+			t.Errorf("SortUniqueFunc(%+q): length %d does not match return value %d", test.input, len(result), got)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package indexed
+
+// FirstUnique rearranges data in place so that the elements for which no
+// earlier element is equivalent, as reported by eq, occupy positions
+// [0, n) in their original relative order, where n is the return value.
+// Unlike SortUnique, FirstUnique does not require data to be sorted, and it
+// leaves the retained elements in their original order rather than sorted
+// order.
+//
+// eq(i, j) reports whether the retained element at position i is
+// equivalent to the candidate at position j — the same retained-then-
+// candidate order as UniqueFunc's eq. As with Partition, eq is always
+// called with respect to the current contents of data, so a typical caller
+// closes over the underlying collection to compare its elements by value.
+//
+// FirstUnique compares each candidate against every element already
+// retained, so it costs time proportional to data.Len()², which is
+// acceptable for the common case of small or already-mostly-unique inputs.
+// FirstUniqueSlice and UniqueFunc apply the same algorithm directly to a
+// plain slice; use one of those when data is already a slice.
+func FirstUnique(data Swapper, eq func(i, j int) bool) int {
+	n := data.Len()
+	i := 0
+outer:
+	for j := 0; j < n; j++ {
+		for k := 0; k < i; k++ {
+			if eq(k, j) {
+				continue outer
+			}
+		}
+		if j != i {
+			data.Swap(i, j)
+		}
+		i++
+	}
+	return i
+}
+
+// FirstUniqueSlice rearranges s in place so that the elements for which no
+// earlier element is equivalent, as reported by eq, occupy the prefix
+// s[:n] in their original relative order, where n is the return value.
+//
+// FirstUniqueSlice is UniqueFunc's engine without the pointer/auto-reslice
+// convention: it operates directly on s, which is always a valid view of
+// the caller's backing array, and the caller reslices to s[:n] itself.
+func FirstUniqueSlice[S ~[]E, E any](s S, eq func(a, b E) bool) int {
+	return uniqueScan[S, E](s, eq)
+}
+
+// FirstUniqueHash rearranges s in place so that the elements whose key,
+// reported by key, has not been seen before occupy the prefix s[:n] in
+// their original relative order, where n is the return value.
+//
+// Unlike FirstUniqueSlice, FirstUniqueHash recognizes duplicates with a
+// map[K]struct{} seen-set, so it costs time proportional to len(s) whenever
+// K is a hashable projection of E. FirstUniqueHash is Unique's engine
+// generalized to a projected key; Unique is the K == E specialization.
+func FirstUniqueHash[S ~[]E, E any, K comparable](s S, key func(E) K) int {
+	return uniqueHash[S, E, K](s, key)
+}
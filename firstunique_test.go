@@ -0,0 +1,52 @@
+package indexed
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFirstUnique(t *testing.T) {
+	words := []string{"a", "b", "a", "c", "b", "a", "d"}
+	n := FirstUnique(sliceSwapper[string](words), func(i, j int) bool {
+		return words[i] == words[j]
+	})
+
+	want := []string{"a", "b", "c", "d"}
+	if n != len(want) {
+		t.Errorf("FirstUnique: got %d, want %d", n, len(want))
+	}
+	if diff := cmp.Diff(want, words[:n]); diff != "" {
+		t.Errorf("FirstUnique: (-want, +got)\n%s", diff)
+	}
+}
+
+func TestFirstUniqueSlice(t *testing.T) {
+	type word string
+	words := []word{"plum", "cherry", "plum", "apple", "cherry"}
+
+	n := FirstUniqueSlice(words, func(a, b word) bool { return a == b })
+
+	want := []word{"plum", "cherry", "apple"}
+	if n != len(want) {
+		t.Errorf("FirstUniqueSlice: got %d, want %d", n, len(want))
+	}
+	if diff := cmp.Diff(want, words[:n]); diff != "" {
+		t.Errorf("FirstUniqueSlice: (-want, +got)\n%s", diff)
+	}
+}
+
+func TestFirstUniqueHash(t *testing.T) {
+	type pair struct{ A, B int }
+	pairs := []pair{{1, 1}, {2, 9}, {1, 2}, {3, 0}, {2, 3}}
+
+	n := FirstUniqueHash(pairs, func(p pair) int { return p.A })
+
+	want := []pair{{1, 1}, {2, 9}, {3, 0}}
+	if n != len(want) {
+		t.Errorf("FirstUniqueHash: got %d, want %d", n, len(want))
+	}
+	if diff := cmp.Diff(want, pairs[:n]); diff != "" {
+		t.Errorf("FirstUniqueHash: (-want, +got)\n%s", diff)
+	}
+}
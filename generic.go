@@ -0,0 +1,75 @@
+package indexed
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Filter modifies *s in-place to remove any elements for which keep returns
+// false. Relative input order is preserved. If s == nil, this function
+// panics.
+//
+// Filter is the generic, non-reflective counterpart of FilterStrings and
+// FilterInts: It requires no Swapper adapter and works for any element type.
+func Filter[T any](s *[]T, keep func(T) bool) int {
+	n := PartitionFunc(*s, keep)
+	*s = (*s)[:n]
+	return n
+}
+
+// PartitionFunc rearranges the elements of s so that all the elements for
+// which keep returns true precede all the elements for which it returns
+// false, and returns the index of the first element that was not kept.
+//
+// The relative input order of the kept elements is preserved, but the
+// unkept elements are permuted arbitrarily. PartitionFunc takes time
+// proportional to len(s) and swaps each kept element at most once.
+//
+// PartitionFunc is the generic, value-based counterpart of Partition: It
+// calls keep with the element itself rather than its index.
+func PartitionFunc[T any](s []T, keep func(T) bool) int {
+	return Partition(sliceSwapper[T](s), func(i int) bool {
+		return keep(s[i])
+	})
+}
+
+// sliceSwapper adapts a slice of any element type to the Swapper interface
+// without reflection.
+type sliceSwapper[T any] []T
+
+func (s sliceSwapper[T]) Len() int      { return len(s) }
+func (s sliceSwapper[T]) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+// SortUniqueBy sorts *s using less and then truncates it in-place so that
+// only the first element of each run of equivalent elements remains. The
+// return value is the number of unique elements, and *s is resliced to that
+// length.
+//
+// SortUniqueBy is the generic counterpart of SortUniqueSlice, built on
+// slices.SortFunc and slices.CompactFunc instead of reflection.
+func SortUniqueBy[T any](s *[]T, less func(a, b T) bool) int {
+	slices.SortFunc(*s, func(a, b T) int {
+		if less(a, b) {
+			return -1
+		} else if less(b, a) {
+			return 1
+		}
+		return 0
+	})
+	*s = slices.CompactFunc(*s, func(a, b T) bool {
+		return !less(a, b) && !less(b, a)
+	})
+	return len(*s)
+}
+
+// SortUniqueOrdered sorts *s and truncates it in-place so that only the
+// first occurrence of each distinct value remains. The return value is the
+// number of unique elements, and *s is resliced to that length.
+//
+// SortUniqueOrdered is the Ordered analogue of SortUniqueBy, built directly
+// on slices.Sort and slices.Compact for element types with a natural order.
+func SortUniqueOrdered[T cmp.Ordered](s *[]T) int {
+	slices.Sort(*s)
+	*s = slices.Compact(*s)
+	return len(*s)
+}
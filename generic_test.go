@@ -0,0 +1,91 @@
+package indexed
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		input, want string
+		keep        func(string) bool
+	}{
+		{"", "", func(string) bool { return true }},
+		{"", "", func(string) bool { return false }},
+		{"drop the names", "drop names", func(s string) bool { return s != "the" }},
+		{"four score and five years", "four five", func(s string) bool { return len(s) == 4 }},
+	}
+	for _, test := range tests {
+		words := strings.Fields(test.input)
+		got := Filter(&words, test.keep)
+		if got != len(words) {
+			t.Errorf("Filter(%q): length %d does not match return value %d", test.input, len(words), got)
+		}
+		want := strings.Fields(test.want)
+		if diff := cmp.Diff(want, words, cmpopts.EquateEmpty()); diff != "" {
+			t.Errorf("Filter(%q): (-want, +got)\n%s", test.input, diff)
+		}
+	}
+}
+
+func TestPartitionFunc(t *testing.T) {
+	//             -  +  +  -  -  +  +
+	input := []int{8, 0, 2, 7, 5, 3, 4}
+
+	n := PartitionFunc(input, func(v int) bool { return v < 5 })
+
+	//            +  +  +  +  -  -  -
+	want := []int{0, 2, 3, 4, 5, 8, 7}
+	if diff := cmp.Diff(want, input); diff != "" {
+		t.Errorf("PartitionFunc %+v: (-want, +got)\n%s", input, diff)
+	}
+	if want := 4; n != want {
+		t.Errorf("PartitionFunc: got breakpoint %d, want %d", n, want)
+	}
+}
+
+func TestSortUniqueBy(t *testing.T) {
+	tests := []struct {
+		input []string
+		want  int
+	}{
+		{nil, 0},
+		{[]string{}, 0},
+		{[]string{"apple"}, 1},
+		{[]string{"plum", "cherry", "apple", "apple", "plum", "apple", "cherry"}, 3},
+	}
+	for _, test := range tests {
+		result := cp(test.input)
+		got := SortUniqueBy(&result, func(a, b string) bool { return a < b })
+		if got != test.want {
+			t.Errorf("SortUniqueBy(%+q): got %d, want %d", test.input, got, test.want)
+		}
+		if len(result) != got {
+			t.Errorf("SortUniqueBy(%+q): length %d does not match return value %d", test.input, len(result), got)
+		}
+	}
+}
+
+func TestSortUniqueOrdered(t *testing.T) {
+	tests := []struct {
+		input []int
+		want  int
+	}{
+		{nil, 0},
+		{[]int{5}, 1},
+		{[]int{3, 1, 3, 2, 1}, 3},
+	}
+	for _, test := range tests {
+		result := append([]int(nil), test.input...)
+		got := SortUniqueOrdered(&result)
+		if got != test.want {
+			t.Errorf("SortUniqueOrdered(%v): got %d, want %d", test.input, got, test.want)
+		}
+		if len(result) != got {
+			t.Errorf("SortUniqueOrdered(%v): length %d does not match return value %d", test.input, len(result), got)
+		}
+	}
+}
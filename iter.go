@@ -0,0 +1,40 @@
+package indexed
+
+import (
+	"iter"
+	"slices"
+)
+
+// FilterSeq returns a sequence that yields the values of in for which keep
+// returns true, skipping the rest. Unlike Filter, FilterSeq does not
+// materialize its input and runs in constant space, streaming one value at
+// a time.
+func FilterSeq[T any](in iter.Seq[T], keep func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range in {
+			if keep(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// PartitionSeq splits in into two sequences, kept containing the values for
+// which keep returns true and dropped containing the rest. The kept values
+// are in their original relative order; the order of the dropped values is
+// unspecified, as with Partition.
+//
+// Because a general iter.Seq cannot be rewound, PartitionSeq must buffer its
+// input; it collects in to a slice and then delegates to the same in-place
+// Partition used by the slice-based API via Collect.
+func PartitionSeq[T any](in iter.Seq[T], keep func(T) bool) (kept, dropped iter.Seq[T]) {
+	return Collect(slices.Collect(in), keep)
+}
+
+// Collect partitions s in place using Partition, then returns the kept and
+// dropped halves as sequences. This lets callers who already have a slice
+// avoid the buffering that PartitionSeq requires for a general iter.Seq.
+func Collect[T any](s []T, keep func(T) bool) (kept, dropped iter.Seq[T]) {
+	n := PartitionFunc(s, keep)
+	return slices.Values(s[:n]), slices.Values(s[n:])
+}
@@ -0,0 +1,49 @@
+package indexed
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFilterSeq(t *testing.T) {
+	in := slices.Values([]int{1, 2, 3, 4, 5, 6})
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	got := slices.Collect(FilterSeq(in, isEven))
+	want := []int{2, 4, 6}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FilterSeq: (-want, +got)\n%s", diff)
+	}
+}
+
+func TestPartitionSeq(t *testing.T) {
+	in := slices.Values([]string{"a", "bb", "ccc", "d", "ee"})
+	short := func(s string) bool { return len(s) == 1 }
+
+	kept, dropped := PartitionSeq(in, short)
+
+	wantKept := []string{"a", "d"}
+	if diff := cmp.Diff(wantKept, slices.Collect(kept)); diff != "" {
+		t.Errorf("PartitionSeq kept: (-want, +got)\n%s", diff)
+	}
+	gotDropped := slices.Collect(dropped)
+	slices.Sort(gotDropped)
+	wantDropped := []string{"bb", "ccc", "ee"}
+	if diff := cmp.Diff(wantDropped, gotDropped); diff != "" {
+		t.Errorf("PartitionSeq dropped: (-want, +got)\n%s", diff)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	s := []int{8, 0, 2, 7, 5, 3, 4}
+	kept, dropped := Collect(s, func(v int) bool { return v < 5 })
+
+	if diff := cmp.Diff([]int{0, 2, 3, 4}, slices.Collect(kept)); diff != "" {
+		t.Errorf("Collect kept: (-want, +got)\n%s", diff)
+	}
+	if diff := cmp.Diff([]int{5, 8, 7}, slices.Collect(dropped)); diff != "" {
+		t.Errorf("Collect dropped: (-want, +got)\n%s", diff)
+	}
+}
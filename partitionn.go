@@ -0,0 +1,61 @@
+package indexed
+
+// PartitionN rearranges the elements of s in place into k contiguous
+// classes, so that all the elements of class 0 precede all the elements of
+// class 1, and so on through class k-1. The class of the element currently
+// at position i is reported by class(i); as with Partition, class is always
+// called with respect to the current contents of s, so a typical caller
+// closes over s to classify its elements by value, e.g.
+//
+//	indexed.PartitionN(s, func(i int) int { return s[i] % k }, k)
+//
+// The return value is a slice of k+1 boundary offsets, such that the
+// elements of class c occupy s[bounds[c]:bounds[c+1]]. Relative order within
+// a class is not preserved.
+//
+// PartitionN panics if k < 0, if k == 0 and s is non-empty, or if class
+// returns a value outside [0, k) for any index it is given, matching the
+// rigor Select applies to its own k argument.
+//
+// PartitionN takes time proportional to len(s) and uses O(k) extra space: It
+// first counts the size of each class, then redistributes the elements into
+// their final positions in a single pass of swaps, generalizing the
+// constant-space, two-way algorithm used by Partition to k buckets.
+func PartitionN[T any](s []T, class func(i int) int, k int) []int {
+	if k < 0 || (k == 0 && len(s) > 0) {
+		panic("indexed: PartitionN requires k > 0 for non-empty s")
+	}
+
+	counts := make([]int, k)
+	for i := range s {
+		counts[checkClass(class(i), k)]++
+	}
+
+	bounds := make([]int, k+1)
+	for c := 0; c < k; c++ {
+		bounds[c+1] = bounds[c] + counts[c]
+	}
+
+	// cursor[c] tracks the next unfilled position in class c's region.
+	cursor := append([]int(nil), bounds[:k]...)
+	for c := 0; c < k; c++ {
+		for cursor[c] < bounds[c+1] {
+			if cc := checkClass(class(cursor[c]), k); cc == c {
+				cursor[c]++
+			} else {
+				s[cursor[c]], s[cursor[cc]] = s[cursor[cc]], s[cursor[c]]
+				cursor[cc]++
+			}
+		}
+	}
+	return bounds
+}
+
+// checkClass verifies that cc, a class index returned by a PartitionN
+// caller's class function, is within [0, k), and panics otherwise.
+func checkClass(cc, k int) int {
+	if cc < 0 || cc >= k {
+		panic("indexed: class returned a value outside [0, k)")
+	}
+	return cc
+}
@@ -0,0 +1,52 @@
+package indexed
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPartitionN(t *testing.T) {
+	s := []int{9, 1, 5, 2, 8, 4, 0, 7, 3, 6}
+	const k = 3
+	classOf := func(v int) int { return v % k }
+
+	bounds := PartitionN(s, func(i int) int { return classOf(s[i]) }, k)
+
+	want := []int{0, 4, 7, 10}
+	if diff := cmp.Diff(want, bounds); diff != "" {
+		t.Fatalf("Bounds: (-want, +got)\n%s", diff)
+	}
+
+	for c := 0; c < k; c++ {
+		bucket := append([]int(nil), s[bounds[c]:bounds[c+1]]...)
+		sort.Ints(bucket)
+		for _, v := range bucket {
+			if classOf(v) != c {
+				t.Errorf("Value %d in class %d bucket, want class %d", v, c, classOf(v))
+			}
+		}
+	}
+}
+
+func TestPartitionNEmpty(t *testing.T) {
+	var s []int
+	bounds := PartitionN(s, func(i int) int { return 0 }, 2)
+	if diff := cmp.Diff([]int{0, 0, 0}, bounds); diff != "" {
+		t.Errorf("Bounds: (-want, +got)\n%s", diff)
+	}
+}
+
+func TestPartitionNNegativeK(t *testing.T) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("PartitionN(nil, _, -1): got no panic, want one")
+		}
+		if msg, ok := r.(string); !ok || msg != "indexed: PartitionN requires k > 0 for non-empty s" {
+			t.Errorf("PartitionN(nil, _, -1): panic value = %v, want the documented precondition message", r)
+		}
+	}()
+	PartitionN[int](nil, func(i int) int { return 0 }, -1)
+}
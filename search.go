@@ -0,0 +1,62 @@
+package indexed
+
+import "sort"
+
+// Search returns the smallest index i in [0, n) for which cmp(i) ≥ 0,
+// assuming cmp(i) is non-decreasing over that range, or n if there is no
+// such index. data bounds the search: it panics if n exceeds data.Len().
+//
+// Search is a three-way-comparator wrapper around sort.Search, intended for
+// use over the sorted, unique-valued prefix left by SortUnique or
+// SortUniqueSlice, so callers don't have to re-derive a comparator over
+// sort.Search's boolean predicate.
+func Search(data Swapper, n int, cmp func(i int) int) int {
+	if n > data.Len() {
+		panic("indexed: n exceeds data.Len()")
+	}
+	return sort.Search(n, func(i int) bool { return cmp(i) >= 0 })
+}
+
+// SearchSlice searches s, which must be sorted in ascending order according
+// to cmp, for target. It returns the position where target is found, or
+// where it would be inserted to keep s sorted, and whether an exact match
+// was found at that position, matching slices.BinarySearchFunc.
+//
+// SearchSlice is the generic companion of Search for a slice that is
+// already sorted, such as the prefix left behind by SortUniqueSlice or
+// SortUniqueFunc.
+func SearchSlice[S ~[]E, E any](s S, target E, cmp func(a, b E) int) (int, bool) {
+	n := len(s)
+	i, j := 0, n
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if cmp(s[h], target) < 0 {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	return i, i < n && cmp(s[i], target) == 0
+}
+
+// SearchBy searches s, which must be sorted in ascending order of key,
+// for the element whose key compares equal to the given key. It returns
+// the position where such an element is found, or where it would be
+// inserted to keep s sorted by key, and whether an exact match was found.
+//
+// SearchBy generalizes SearchSlice to the case where the search key is not
+// itself an element of s, letting callers reuse the same comparator they
+// used to produce s with SortUniqueFunc.
+func SearchBy[S ~[]E, E, K any](s S, key K, cmp func(e E, k K) int) (int, bool) {
+	n := len(s)
+	i, j := 0, n
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if cmp(s[h], key) < 0 {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	return i, i < n && cmp(s[i], key) == 0
+}
@@ -0,0 +1,74 @@
+package indexed
+
+import "testing"
+
+func TestSearch(t *testing.T) {
+	s := []string{"apple", "cherry", "pear", "plum"}
+	n := SortUniqueOrdered(&s)
+
+	tests := []struct {
+		target string
+		want   int
+	}{
+		{"apple", 0},
+		{"pear", 2},
+		{"banana", 1}, // would insert between apple and cherry
+		{"zebra", n},
+	}
+	for _, test := range tests {
+		got := Search(sliceSwapper[string](s), n, func(i int) int {
+			switch {
+			case s[i] < test.target:
+				return -1
+			case s[i] > test.target:
+				return 1
+			default:
+				return 0
+			}
+		})
+		if got != test.want {
+			t.Errorf("Search(%q): got %d, want %d", test.target, got, test.want)
+		}
+	}
+}
+
+func TestSearchSlice(t *testing.T) {
+	s := []int{1, 3, 5, 7, 9}
+	cmp := func(a, b int) int { return a - b }
+
+	tests := []struct {
+		target    int
+		wantPos   int
+		wantFound bool
+	}{
+		{5, 2, true},
+		{4, 2, false},
+		{0, 0, false},
+		{10, 5, false},
+	}
+	for _, test := range tests {
+		pos, found := SearchSlice(s, test.target, cmp)
+		if pos != test.wantPos || found != test.wantFound {
+			t.Errorf("SearchSlice(%d): got (%d, %v), want (%d, %v)", test.target, pos, found, test.wantPos, test.wantFound)
+		}
+	}
+}
+
+func TestSearchBy(t *testing.T) {
+	type item struct {
+		Name string
+		Key  int
+	}
+	s := []item{{"a", 1}, {"b", 3}, {"c", 5}, {"d", 7}}
+	cmp := func(e item, k int) int { return e.Key - k }
+
+	pos, found := SearchBy(s, 5, cmp)
+	if pos != 2 || !found {
+		t.Errorf("SearchBy(5): got (%d, %v), want (2, true)", pos, found)
+	}
+
+	pos, found = SearchBy(s, 4, cmp)
+	if pos != 2 || found {
+		t.Errorf("SearchBy(4): got (%d, %v), want (2, false)", pos, found)
+	}
+}
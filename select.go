@@ -0,0 +1,143 @@
+package indexed
+
+import "math/bits"
+
+// Select partially rearranges v so that the k smallest elements, as ordered
+// by less, occupy positions [0, k) in unspecified order, and every element
+// at or after position k compares ≥ all of them. It panics if k is negative,
+// and does nothing if k ≥ v.Len().
+//
+// Select uses introselect: ordinary quickselect with a median-of-three
+// pivot, falling back to a median-of-medians pivot whenever a logarithmic
+// budget of unbalanced partitions is exceeded. This guarantees O(v.Len())
+// worst-case time, unlike sort.Sort followed by slicing, which costs
+// O(n log n).
+func Select(v Swapper, less func(i, j int) bool, k int) {
+	if k < 0 {
+		panic("indexed: negative k")
+	}
+	n := v.Len()
+	if k >= n {
+		return
+	}
+	introselect(v, less, 0, n, k, 2*bits.Len(uint(n)))
+}
+
+// TopK reorders s so that the k least elements, as ordered by less, occupy
+// the first k positions in unspecified order, and returns that prefix. If k
+// is greater than len(s), TopK returns all of s.
+//
+// TopK is a generic wrapper around Select for plain slices.
+func TopK[T any](s []T, k int, less func(a, b T) bool) []T {
+	if k > len(s) {
+		k = len(s)
+	}
+	sw := sliceSwapper[T](s)
+	Select(sw, func(i, j int) bool { return less(s[i], s[j]) }, k)
+	return s[:k]
+}
+
+// introselect selects the element that belongs at index k within [lo, hi)
+// of v, partially rearranging v around it. depth bounds the number of
+// unbalanced quickselect partitions permitted before falling back to a
+// guaranteed-good median-of-medians pivot.
+func introselect(v Swapper, less func(i, j int) bool, lo, hi, k, depth int) {
+	for {
+		if hi-lo <= 1 {
+			return
+		}
+		if hi-lo <= 5 {
+			insertionSortRange(v, less, lo, hi)
+			return
+		}
+
+		var pivot int
+		if depth <= 0 {
+			pivot = medianOfMedians(v, less, lo, hi)
+			depth = 2 * bits.Len(uint(hi-lo))
+		} else {
+			mid := lo + (hi-lo)/2
+			pivot = medianOfThreeIndex(less, lo, mid, hi-1)
+		}
+
+		p := partitionAround(v, less, lo, hi, pivot)
+		// A partition is unbalanced if its smaller side holds less than an
+		// eighth of the range.
+		if min(p-lo, hi-1-p) < (hi-lo)/8 {
+			depth--
+		}
+		switch {
+		case k == p:
+			return
+		case k < p:
+			hi = p
+		default:
+			lo = p + 1
+		}
+	}
+}
+
+// medianOfMedians computes, and leaves in place, a pivot index within
+// [lo, hi) using the classic median-of-medians-of-five construction. It
+// guarantees the chosen pivot discards at least a constant fraction of the
+// range on every partition, bounding worst-case recursion.
+func medianOfMedians(v Swapper, less func(i, j int) bool, lo, hi int) int {
+	write := lo
+	for gl := lo; gl < hi; gl += 5 {
+		gh := min(gl+5, hi)
+		insertionSortRange(v, less, gl, gh)
+		v.Swap(write, gl+(gh-gl-1)/2)
+		write++
+	}
+	mid := lo + (write-lo)/2
+	introselect(v, less, lo, write, mid, 2*bits.Len(uint(write-lo)))
+	return mid
+}
+
+// medianOfThreeIndex returns whichever of a, b, c is the median according to
+// less, used to pick a pivot that avoids worst-case behavior on sorted or
+// reverse-sorted input.
+func medianOfThreeIndex(less func(i, j int) bool, a, b, c int) int {
+	switch {
+	case less(a, b):
+		if less(b, c) {
+			return b
+		} else if less(a, c) {
+			return c
+		}
+		return a
+	case less(a, c):
+		return a
+	case less(b, c):
+		return c
+	default:
+		return b
+	}
+}
+
+// partitionAround partitions v[lo:hi] around the element at pivot, using
+// the standard Lomuto scheme, and returns the pivot's final index.
+func partitionAround(v Swapper, less func(i, j int) bool, lo, hi, pivot int) int {
+	last := hi - 1
+	v.Swap(pivot, last)
+	store := lo
+	for i := lo; i < last; i++ {
+		if less(i, last) {
+			v.Swap(i, store)
+			store++
+		}
+	}
+	v.Swap(store, last)
+	return store
+}
+
+// insertionSortRange sorts v[lo:hi] in place by repeated swaps; it is only
+// used on the small ranges produced by introselect's base case and the
+// groups-of-five scan in medianOfMedians.
+func insertionSortRange(v Swapper, less func(i, j int) bool, lo, hi int) {
+	for i := lo + 1; i < hi; i++ {
+		for j := i; j > lo && less(j, j-1); j-- {
+			v.Swap(j, j-1)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package indexed
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestSelect(t *testing.T) {
+	less := func(s []int) func(i, j int) bool {
+		return func(i, j int) bool { return s[i] < s[j] }
+	}
+
+	for _, n := range []int{0, 1, 2, 5, 7, 20, 137} {
+		for _, k := range []int{0, 1, n / 2, n - 1, n} {
+			if k < 0 || k > n {
+				continue
+			}
+			s := rand.New(rand.NewSource(int64(n*1000 + k))).Perm(n)
+			want := append([]int(nil), s...)
+			sort.Ints(want)
+
+			Select(sliceSwapper[int](s), less(s), k)
+
+			if k > 0 {
+				gotPrefix := append([]int(nil), s[:k]...)
+				sort.Ints(gotPrefix)
+				if !equalInts(gotPrefix, want[:k]) {
+					t.Errorf("Select(n=%d, k=%d): prefix %v, want (as set) %v", n, k, s[:k], want[:k])
+				}
+			}
+			if k > 0 && k < n {
+				for _, v := range s[k:] {
+					if v < want[k-1] {
+						t.Errorf("Select(n=%d, k=%d): suffix element %d is less than kth smallest %d", n, k, v, want[k-1])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestTopK(t *testing.T) {
+	s := []int{9, 3, 7, 1, 8, 2, 6, 4, 5, 0}
+	got := TopK(s, 3, func(a, b int) bool { return a < b })
+	sort.Ints(got)
+	want := []int{0, 1, 2}
+	if !equalInts(got, want) {
+		t.Errorf("TopK(3): got %v, want %v", got, want)
+	}
+}
+
+func TestTopKAll(t *testing.T) {
+	s := []int{3, 1, 2}
+	got := TopK(s, 5, func(a, b int) bool { return a < b })
+	if len(got) != 3 {
+		t.Errorf("TopK(5) on a 3-element slice: got length %d, want 3", len(got))
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,82 @@
+// Package slicesx provides generic entry points for the algorithms in
+// package indexed, typed in the style of the standard library's slices
+// package: Functions accept a named slice type S ~[]E directly, rather than
+// a sort.Interface or Swapper adapter, so callers no longer need to write a
+// one-off wrapper type for each element type they want to filter or sort.
+//
+// The functions here are thin wrappers around the corresponding
+// sort.Interface-based functions in package indexed, which remain as they
+// are for callers who already have their own Swapper or sort.Interface
+// implementations.
+package slicesx
+
+import "github.com/creachadair/indexed"
+
+// adapter lets a named slice type S ~[]E satisfy indexed.Swapper and
+// sort.Interface without reflection.
+type adapter[S ~[]E, E any] struct {
+	s    S
+	less func(a, b E) bool
+}
+
+func (a adapter[S, E]) Len() int           { return len(a.s) }
+func (a adapter[S, E]) Swap(i, j int)      { a.s[i], a.s[j] = a.s[j], a.s[i] }
+func (a adapter[S, E]) Less(i, j int) bool { return a.less(a.s[i], a.s[j]) }
+
+// Filter returns the result of removing from s the elements for which keep
+// returns false, preserving relative order. Like slices.DeleteFunc and
+// slices.CompactFunc, Filter takes s and returns the (possibly shorter)
+// result directly rather than through a pointer; callers assign the result
+// back: s = slicesx.Filter(s, keep).
+//
+// Filter is the named-slice-preserving counterpart of FilterStrings and
+// FilterInts, without requiring a generated Swapper wrapper.
+func Filter[S ~[]E, E any](s S, keep func(E) bool) S {
+	n := indexed.Partition(adapter[S, E]{s: s}, func(i int) bool { return keep(s[i]) })
+	return s[:n]
+}
+
+// PartitionFunc rearranges s in place so that all the elements for which
+// keep returns true precede all the elements for which it returns false, and
+// returns the index of the first element that was not kept. The relative
+// order of the kept elements is preserved.
+//
+// PartitionFunc is the value-based counterpart of Partition: It calls keep
+// with the element itself rather than its index, mirroring the relationship
+// between indexed.PartitionFunc and indexed.Partition.
+func PartitionFunc[S ~[]E, E any](s S, keep func(E) bool) int {
+	return indexed.Partition(adapter[S, E]{s: s}, func(i int) bool { return keep(s[i]) })
+}
+
+// Partition rearranges s in place according to keep, exactly like
+// indexed.Partition and indexed.PartitionSlice, but accepts s directly as a
+// named slice type S instead of requiring a Swapper adapter or reflection.
+func Partition[S ~[]E, E any](s S, keep func(i int) bool) int {
+	return indexed.Partition(adapter[S, E]{s: s}, keep)
+}
+
+// SortUnique sorts *s using less and then truncates it in-place so that
+// only the first element of each run of equivalent elements remains. The
+// return value is the number of unique elements, and *s is resliced to that
+// length.
+//
+// SortUnique is the pointer, auto-truncating counterpart of SortUniqueSlice;
+// use SortUniqueSlice directly when s need not be resliced by the callee.
+func SortUnique[S ~[]E, E any](s *S, less func(a, b E) bool) int {
+	v := *s
+	n := indexed.SortUnique(adapter[S, E]{s: v, less: less})
+	*s = v[:n]
+	return n
+}
+
+// SortUniqueSlice sorts s in place and partitions it so that all the
+// elements left of the partition point are unique, and any duplicates are
+// to the right of the partition. The number of unique elements is returned;
+// unlike SortUnique, s itself is not resliced, so the caller must do
+// s = s[:n] to discard the duplicates.
+//
+// SortUniqueSlice is the named-slice-preserving counterpart of
+// indexed.SortUniqueSlice, without requiring reflection.
+func SortUniqueSlice[S ~[]E, E any](s S, less func(a, b E) bool) int {
+	return indexed.SortUnique(adapter[S, E]{s: s, less: less})
+}
@@ -0,0 +1,78 @@
+package slicesx
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type wordList []string
+
+func TestFilter(t *testing.T) {
+	words := wordList{"drop", "the", "names"}
+	words = Filter(words, func(s string) bool { return s != "the" })
+
+	want := wordList{"drop", "names"}
+	if diff := cmp.Diff(want, words); diff != "" {
+		t.Errorf("Filter: (-want, +got)\n%s", diff)
+	}
+}
+
+func TestPartitionFunc(t *testing.T) {
+	//             -  +  +  -  -  +  +
+	s := []int{8, 0, 2, 7, 5, 3, 4}
+
+	n := PartitionFunc[[]int, int](s, func(v int) bool { return v < 5 })
+
+	//            +  +  +  +  -  -  -
+	want := []int{0, 2, 3, 4, 5, 8, 7}
+	if diff := cmp.Diff(want, s); diff != "" {
+		t.Errorf("PartitionFunc: (-want, +got)\n%s", diff)
+	}
+	if want := 4; n != want {
+		t.Errorf("PartitionFunc: got breakpoint %d, want %d", n, want)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	s := wordList{"join", "us", "now", "and", "share", "the", "software"}
+	n := Partition(s, func(i int) bool { return len(s[i]) <= 3 })
+
+	want := wordList{"us", "now", "and", "the", "share", "join", "software"}
+	if diff := cmp.Diff(want, s); diff != "" {
+		t.Errorf("Partition: (-want, +got)\n%s", diff)
+	}
+	if want := 4; n != want {
+		t.Errorf("Partition: got breakpoint %d, want %d", n, want)
+	}
+}
+
+func TestSortUnique(t *testing.T) {
+	words := wordList{"plum", "cherry", "apple", "apple", "plum", "apple", "cherry"}
+	n := SortUnique(&words, func(a, b string) bool { return a < b })
+
+	if want := 3; n != want {
+		t.Errorf("SortUnique: got %d, want %d", n, want)
+	}
+	if !sort.StringsAreSorted(words) {
+		t.Errorf("SortUnique: result is not sorted: %+q", words)
+	}
+}
+
+func TestSortUniqueSlice(t *testing.T) {
+	words := wordList{"plum", "cherry", "apple", "apple", "plum", "apple", "cherry"}
+	n := SortUniqueSlice(words, func(a, b string) bool { return a < b })
+
+	if want := 3; n != want {
+		t.Errorf("SortUniqueSlice: got %d, want %d", n, want)
+	}
+	if !sort.StringsAreSorted(words[:n]) {
+		t.Errorf("SortUniqueSlice: result is not sorted: %+q", words[:n])
+	}
+	words = words[:n]
+	want := wordList{"apple", "cherry", "plum"}
+	if diff := cmp.Diff(want, words); diff != "" {
+		t.Errorf("SortUniqueSlice: (-want, +got)\n%s", diff)
+	}
+}
@@ -0,0 +1,91 @@
+package indexed
+
+// StablePartition rearranges the elements of data so that all the elements
+// for which keep returns true precede all the elements for which it
+// returns false, and returns the index of the first element that was not
+// kept. Unlike Partition, the relative order of both groups is preserved.
+//
+// StablePartition recursively splits the range in half, stably partitions
+// each half, then rotates the unkept suffix of the left half past the kept
+// prefix of the right half. This costs O(data.Len() log data.Len()) time
+// and O(log data.Len()) stack space. See StablePartitionBuffered for an
+// O(n)-time, O(n)-space alternative.
+func StablePartition(data Swapper, keep func(i int) bool) int {
+	return stablePartitionRange(data, keep, 0, data.Len())
+}
+
+func stablePartitionRange(data Swapper, keep func(i int) bool, lo, hi int) int {
+	if hi-lo <= 1 {
+		if hi > lo && !keep(lo) {
+			return lo
+		}
+		return hi
+	}
+	mid := lo + (hi-lo)/2
+	leftBound := stablePartitionRange(data, keep, lo, mid)
+	rightBound := stablePartitionRange(data, keep, mid, hi)
+
+	// [lo, leftBound) kept, [leftBound, mid) dropped, [mid, rightBound) kept,
+	// [rightBound, hi) dropped. Rotate the middle two runs so the kept run
+	// from the right half precedes the dropped run from the left half.
+	rotate(data, leftBound, mid, rightBound)
+	return leftBound + (rightBound - mid)
+}
+
+// rotate exchanges the blocks data[lo:mid] and data[mid:hi] in place, using
+// the classic three-reversal trick.
+func rotate(data Swapper, lo, mid, hi int) {
+	reverseRange(data, lo, mid)
+	reverseRange(data, mid, hi)
+	reverseRange(data, lo, hi)
+}
+
+func reverseRange(data Swapper, lo, hi int) {
+	for i, j := lo, hi-1; i < j; i, j = i+1, j-1 {
+		data.Swap(i, j)
+	}
+}
+
+// StablePartitionBuffered rearranges the elements of data exactly like
+// StablePartition, but computes the destination of every element in a
+// single pass and then applies that permutation by following its cycles.
+// This costs O(data.Len()) time, using an auxiliary buffer of
+// data.Len() ints rather than recursion.
+func StablePartitionBuffered(data Swapper, keep func(i int) bool) int {
+	n := data.Len()
+	kept := 0
+	for i := 0; i < n; i++ {
+		if keep(i) {
+			kept++
+		}
+	}
+
+	// perm[i] is the position the element currently at i must move to.
+	perm := make([]int, n)
+	ki, di := 0, kept
+	for i := 0; i < n; i++ {
+		if keep(i) {
+			perm[i] = ki
+			ki++
+		} else {
+			perm[i] = di
+			di++
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		for perm[i] != i {
+			j := perm[i]
+			data.Swap(i, j)
+			perm[i], perm[j] = perm[j], perm[i]
+		}
+	}
+	return kept
+}
+
+// StablePartitionSlice rearranges s in place according to keep, exactly
+// like StablePartition, but calls keep with the element itself rather than
+// its index.
+func StablePartitionSlice[T any](s []T, keep func(T) bool) int {
+	return StablePartition(sliceSwapper[T](s), func(i int) bool { return keep(s[i]) })
+}
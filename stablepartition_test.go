@@ -0,0 +1,52 @@
+package indexed
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStablePartition(t *testing.T) {
+	//             -  +  +  -  -  +  +
+	input := []int{8, 0, 2, 7, 5, 3, 4}
+	got := append([]int(nil), input...)
+
+	n := StablePartition(sliceSwapper[int](got), func(i int) bool { return got[i] < 5 })
+
+	//            +  +  +  +  -  -  -
+	want := []int{0, 2, 3, 4, 8, 7, 5}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("StablePartition %+v: (-want, +got)\n%s", input, diff)
+	}
+	if wantN := 4; n != wantN {
+		t.Errorf("StablePartition: got breakpoint %d, want %d", n, wantN)
+	}
+}
+
+func TestStablePartitionBuffered(t *testing.T) {
+	input := []int{8, 0, 2, 7, 5, 3, 4}
+	got := append([]int(nil), input...)
+
+	n := StablePartitionBuffered(sliceSwapper[int](got), func(i int) bool { return got[i] < 5 })
+
+	want := []int{0, 2, 3, 4, 8, 7, 5}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("StablePartitionBuffered %+v: (-want, +got)\n%s", input, diff)
+	}
+	if wantN := 4; n != wantN {
+		t.Errorf("StablePartitionBuffered: got breakpoint %d, want %d", n, wantN)
+	}
+}
+
+func TestStablePartitionSlice(t *testing.T) {
+	words := []string{"join", "us", "now", "and", "share", "the", "software"}
+	n := StablePartitionSlice(words, func(s string) bool { return len(s) <= 3 })
+
+	want := []string{"us", "now", "and", "the", "join", "share", "software"}
+	if diff := cmp.Diff(want, words); diff != "" {
+		t.Errorf("StablePartitionSlice: (-want, +got)\n%s", diff)
+	}
+	if wantN := 4; n != wantN {
+		t.Errorf("StablePartitionSlice: got breakpoint %d, want %d", n, wantN)
+	}
+}
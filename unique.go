@@ -0,0 +1,74 @@
+package indexed
+
+// Unique removes duplicate elements from *s in-place, preserving the
+// relative order of their first occurrence. The return value is the number
+// of unique elements, and *s is resliced to that length.
+//
+// Unlike SortUnique and its relatives, Unique does not sort its input, so
+// the original order of the surviving elements is preserved. It uses a
+// map[T]struct{} to recognize elements already seen, so it costs time and
+// space proportional to len(*s).
+//
+// Unique is the identity-keyed specialization of FirstUniqueHash.
+func Unique[T comparable](s *[]T) int {
+	v := *s
+	n := uniqueHash[[]T, T, T](v, func(x T) T { return x })
+	*s = v[:n]
+	return n
+}
+
+// UniqueFunc removes duplicate elements from *s in-place, preserving the
+// relative order of their first occurrence, where two elements are
+// considered duplicates if eq reports true for them. The return value is
+// the number of unique elements, and *s is resliced to that length.
+//
+// UniqueFunc compares each candidate against all of the elements already
+// retained, so it costs time proportional to len(*s)².  Use Unique instead
+// when T is comparable and equality is appropriate.
+//
+// UniqueFunc shares its engine with FirstUniqueSlice, which offers the same
+// algorithm without the pointer/auto-reslice convention.
+func UniqueFunc[T any](s *[]T, eq func(a, b T) bool) int {
+	v := *s
+	n := uniqueScan[[]T, T](v, eq)
+	*s = v[:n]
+	return n
+}
+
+// uniqueHash rearranges s in place so that the elements whose key, reported
+// by key, has not been seen before occupy the prefix s[:n], where n is the
+// return value. It is the shared engine behind Unique and FirstUniqueHash.
+func uniqueHash[S ~[]E, E any, K comparable](s S, key func(E) K) int {
+	seen := make(map[K]struct{}, len(s))
+	i := 0
+	for j, x := range s {
+		k := key(x)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		s[i], s[j] = s[j], s[i]
+		i++
+	}
+	return i
+}
+
+// uniqueScan rearranges s in place so that the elements for which no earlier
+// retained element is eq-equivalent occupy the prefix s[:n], where n is the
+// return value. eq(a, b) reports whether a, an already-retained element, is
+// equivalent to b, a later candidate. It is the shared engine behind
+// UniqueFunc and FirstUniqueSlice.
+func uniqueScan[S ~[]E, E any](s S, eq func(a, b E) bool) int {
+	i := 0
+loop:
+	for k, x := range s {
+		for j := 0; j < i; j++ {
+			if eq(s[j], x) {
+				continue loop
+			}
+		}
+		s[i], s[k] = s[k], s[i]
+		i++
+	}
+	return i
+}
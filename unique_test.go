@@ -0,0 +1,47 @@
+package indexed
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestUnique(t *testing.T) {
+	tests := []struct {
+		input []string
+		want  []string
+	}{
+		{nil, nil},
+		{[]string{}, nil},
+		{[]string{"a"}, []string{"a"}},
+		{[]string{"a", "b", "a", "c", "b", "a"}, []string{"a", "b", "c"}},
+		{[]string{"x", "x", "x"}, []string{"x"}},
+	}
+	for _, test := range tests {
+		result := append([]string(nil), test.input...)
+		got := Unique(&result)
+		if got != len(test.want) {
+			t.Errorf("Unique(%+q): got %d, want %d", test.input, got, len(test.want))
+		}
+		if diff := cmp.Diff(test.want, result, cmpopts.EquateEmpty()); diff != "" {
+			t.Errorf("Unique(%+q): (-want, +got)\n%s", test.input, diff)
+		}
+	}
+}
+
+func TestUniqueFunc(t *testing.T) {
+	type pair struct{ A, B int }
+	sameA := func(p, q pair) bool { return p.A == q.A }
+
+	input := []pair{{1, 1}, {2, 1}, {1, 2}, {3, 1}, {2, 2}}
+	want := []pair{{1, 1}, {2, 1}, {3, 1}}
+
+	got := UniqueFunc(&input, sameA)
+	if got != len(want) {
+		t.Errorf("UniqueFunc: got %d, want %d", got, len(want))
+	}
+	if diff := cmp.Diff(want, input); diff != "" {
+		t.Errorf("UniqueFunc: (-want, +got)\n%s", diff)
+	}
+}